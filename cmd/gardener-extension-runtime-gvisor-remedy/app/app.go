@@ -0,0 +1,97 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/remedy"
+)
+
+// ExtensionName is the name of the remedy binary, which runs against a shoot cluster for one worker pool. The
+// ContainerRuntime actuators in this repository do not deploy it.
+const ExtensionName = "gardener-extension-runtime-gvisor-remedy"
+
+// Options bundles the command line options for the remedy binary.
+type Options struct {
+	SeedKubeconfigPath        string
+	ContainerRuntimeNamespace string
+	ContainerRuntimeName      string
+	WorkerPoolName            string
+	GracePeriod               time.Duration
+	ConcurrentSyncs           int
+}
+
+// AddFlags adds the options' flags to the given FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.SeedKubeconfigPath, "seed-kubeconfig", "", "Path to the kubeconfig of the seed cluster holding the ContainerRuntime resource for this node pool.")
+	fs.StringVar(&o.ContainerRuntimeNamespace, "containerruntime-namespace", "", "Namespace of the ContainerRuntime resource for this node pool.")
+	fs.StringVar(&o.ContainerRuntimeName, "containerruntime-name", "", "Name of the ContainerRuntime resource for this node pool.")
+	fs.StringVar(&o.WorkerPoolName, "worker-pool-name", "", "Name of the worker pool this remedy instance is responsible for; only nodes of this pool are watched.")
+	fs.DurationVar(&o.GracePeriod, "grace-period", remedy.DefaultAddOptions.GracePeriod, "How long a node is given to recover before the ContainerRuntime resource is force-reconciled.")
+	fs.IntVar(&o.ConcurrentSyncs, "concurrent-syncs", remedy.DefaultAddOptions.ConcurrentSyncs, "Number of workers used to reconcile nodes.")
+}
+
+// NewRemedyCommand creates a new command for running the gVisor remedy controller.
+func NewRemedyCommand(ctx context.Context) *cobra.Command {
+	options := &Options{
+		GracePeriod:     remedy.DefaultAddOptions.GracePeriod,
+		ConcurrentSyncs: remedy.DefaultAddOptions.ConcurrentSyncs,
+	}
+
+	cmd := &cobra.Command{
+		Use:   ExtensionName,
+		Short: "Self-healing controller that recovers shoot nodes with broken runsc installations.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if options.ContainerRuntimeNamespace == "" || options.ContainerRuntimeName == "" || options.WorkerPoolName == "" {
+				return fmt.Errorf("--containerruntime-namespace, --containerruntime-name and --worker-pool-name are required")
+			}
+
+			seedClient, err := newSeedClient(options.SeedKubeconfigPath)
+			if err != nil {
+				return fmt.Errorf("could not create seed client: %w", err)
+			}
+
+			mgr, err := manager.New(ctrlconfig.GetConfigOrDie(), manager.Options{})
+			if err != nil {
+				return fmt.Errorf("could not instantiate manager: %w", err)
+			}
+
+			if err := remedy.AddToManager(mgr, remedy.AddOptions{
+				SeedClient:          seedClient,
+				ContainerRuntimeKey: client.ObjectKey{Namespace: options.ContainerRuntimeNamespace, Name: options.ContainerRuntimeName},
+				WorkerPoolName:      options.WorkerPoolName,
+				GracePeriod:         options.GracePeriod,
+				ConcurrentSyncs:     options.ConcurrentSyncs,
+			}); err != nil {
+				return fmt.Errorf("could not add the remedy controller to manager: %w", err)
+			}
+
+			return mgr.Start(ctx)
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
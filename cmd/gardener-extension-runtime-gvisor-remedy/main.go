@@ -0,0 +1,35 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/cmd/gardener-extension-runtime-gvisor-remedy/app"
+)
+
+func main() {
+	log.SetLogger(logzap.New(logzap.UseDevMode(false)))
+
+	ctx := signals.SetupSignalHandler()
+	if err := app.NewRemedyCommand(ctx).Execute(); err != nil {
+		log.Log.Error(err, "error executing the command")
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"fmt"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	"github.com/spf13/cobra"
+
+	runtimegvisorcontrollercmd "github.com/Kristian-ZH/gardener-extension-runtime-gvisor/cmd/gardener-extension-runtime-gvisor/app/config"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/containerruntime"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/healthcheck"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/webhook"
+)
+
+// ExtensionName is the name of the extension binary.
+const ExtensionName = "gardener-extension-runtime-gvisor"
+
+// NewControllerManagerCommand creates a new command for running the gVisor ContainerRuntime extension controllers.
+func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
+	options := runtimegvisorcontrollercmd.NewOptions()
+
+	cmd := &cobra.Command{
+		Use:   ExtensionName,
+		Short: "Gardener extension controllers for the gVisor ContainerRuntime.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Complete(); err != nil {
+				return err
+			}
+
+			mgr, err := extensionscmdcontroller.NewManager(ctx, options.RESTOptions.Completed(), options.ManagerOptions.Completed())
+			if err != nil {
+				return fmt.Errorf("could not instantiate manager: %w", err)
+			}
+
+			if err := containerruntime.AddToManager(mgr, options.EnabledRuntimes); err != nil {
+				return fmt.Errorf("could not add the containerruntime controller to manager: %w", err)
+			}
+
+			if err := healthcheck.AddToManager(mgr, options.HealthCheckOptions.Completed()); err != nil {
+				return fmt.Errorf("could not add the healthcheck controller to manager: %w", err)
+			}
+
+			if err := webhook.AddToManager(mgr); err != nil {
+				return fmt.Errorf("could not add the admission webhooks to manager: %w", err)
+			}
+
+			return mgr.Start(ctx)
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+
+	return cmd
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package config bundles the command line options for the gVisor ContainerRuntime extension.
+package config
+
+import (
+	"time"
+
+	extensionscmdcontroller "github.com/gardener/gardener/extensions/pkg/controller/cmd"
+	"github.com/spf13/pflag"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/healthcheck"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+const (
+	healthCheckSyncPeriodFlag      = "healthcheck-sync-period"
+	healthCheckConcurrentSyncsFlag = "healthcheck-concurrent-syncs"
+	enabledRuntimesFlag            = "enabled-runtimes"
+)
+
+// Options bundles the configuration for the gVisor ContainerRuntime extension binary.
+type Options struct {
+	RESTOptions    *extensionscmdcontroller.RESTOptions
+	ManagerOptions *extensionscmdcontroller.ManagerOptions
+
+	healthCheckSyncPeriod      time.Duration
+	healthCheckConcurrentSyncs int
+
+	// EnabledRuntimes is the set of ContainerRuntime types this extension instance hosts an Actuator for.
+	EnabledRuntimes []string
+
+	HealthCheckOptions healthCheckOptions
+}
+
+type healthCheckOptions struct {
+	opts *Options
+}
+
+// Completed returns the AddOptions expected by the healthcheck controller.
+func (h healthCheckOptions) Completed() healthcheck.AddOptions {
+	return healthcheck.AddOptions{
+		SyncPeriod:      h.opts.healthCheckSyncPeriod,
+		ConcurrentSyncs: h.opts.healthCheckConcurrentSyncs,
+	}
+}
+
+// NewOptions creates a new Options instance with its defaults pre-filled.
+func NewOptions() *Options {
+	options := &Options{
+		RESTOptions:    &extensionscmdcontroller.RESTOptions{},
+		ManagerOptions: &extensionscmdcontroller.ManagerOptions{},
+
+		healthCheckSyncPeriod:      healthcheck.DefaultAddOptions.SyncPeriod,
+		healthCheckConcurrentSyncs: healthcheck.DefaultAddOptions.ConcurrentSyncs,
+
+		EnabledRuntimes: []string{gvisor.Type},
+	}
+	options.HealthCheckOptions = healthCheckOptions{opts: options}
+	return options
+}
+
+// AddFlags adds the options' flags to the given FlagSet.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	o.RESTOptions.AddFlags(fs)
+	o.ManagerOptions.AddFlags(fs)
+
+	fs.DurationVar(&o.healthCheckSyncPeriod, healthCheckSyncPeriodFlag, o.healthCheckSyncPeriod, "Period after which the health of existing ContainerRuntime resources is re-checked.")
+	fs.IntVar(&o.healthCheckConcurrentSyncs, healthCheckConcurrentSyncsFlag, o.healthCheckConcurrentSyncs, "Number of workers used to reconcile the health check controller.")
+	fs.StringSliceVar(&o.EnabledRuntimes, enabledRuntimesFlag, o.EnabledRuntimes, "ContainerRuntime types this extension instance hosts an actuator for (e.g. gvisor, kata-containers).")
+}
+
+// Complete validates and completes the options.
+func (o *Options) Complete() error {
+	if err := o.RESTOptions.Complete(); err != nil {
+		return err
+	}
+	return o.ManagerOptions.Complete()
+}
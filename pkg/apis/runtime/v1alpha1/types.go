@@ -0,0 +1,32 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ContainerRuntimeConfig contains the provider config for the gVisor ContainerRuntime extension.
+// It is embedded in `ContainerRuntime.spec.providerConfig`.
+type ContainerRuntimeConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Debug requests verbose `runsc` logging and debug flags. Defaults to false. The gVisor actuator in this
+	// repository does not read this field, so setting it does not change any `runsc` flags.
+	// +optional
+	Debug *bool `json:"debug,omitempty"`
+}
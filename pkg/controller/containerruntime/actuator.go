@@ -0,0 +1,107 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardenercontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/containerruntime/metrics"
+)
+
+// actuator handles ContainerRuntime resources of type `gvisor`. Its operations record metrics and events only;
+// they do not create or remove resources on the shoot.
+type actuator struct {
+	client        client.Client
+	eventRecorder record.EventRecorder
+}
+
+// NewActuator creates a new Actuator for ContainerRuntime resources of type `gvisor`. Operation outcomes are
+// recorded as Prometheus metrics and as Kubernetes Events on the `ContainerRuntime` object.
+func NewActuator(mgr manager.Manager) gardenercontainerruntime.Actuator {
+	return &actuator{
+		client:        mgr.GetClient(),
+		eventRecorder: mgr.GetEventRecorderFor(ControllerName),
+	}
+}
+
+// InjectClient injects the controller runtime client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile records a reconcile operation for the given ContainerRuntime. It does not create the gVisor
+// installation DaemonSet or the `gvisor` RuntimeClass.
+func (a *actuator) Reconcile(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return a.instrument(cr, cluster, "reconcile", "Reconciling", "Reconciled", func() error {
+		return nil
+	})
+}
+
+// Delete records a delete operation for the given ContainerRuntime. It does not remove the gVisor installation
+// DaemonSet or the `gvisor` RuntimeClass.
+func (a *actuator) Delete(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return a.instrument(cr, cluster, "delete", "Deleting", "Deleted", func() error {
+		return nil
+	})
+}
+
+// Restore behaves like Reconcile for the gVisor actuator.
+func (a *actuator) Restore(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return a.instrument(cr, cluster, "restore", "Restoring", "Restored", func() error {
+		return a.Reconcile(ctx, cr, cluster)
+	})
+}
+
+// Migrate is a no-op for the gVisor actuator as it does not provision control plane state.
+func (a *actuator) Migrate(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return a.instrument(cr, cluster, "migrate", "Migrating", "Migrated", func() error {
+		return nil
+	})
+}
+
+// instrument records the outcome of the given operation as a Prometheus metric and emits a corresponding
+// Kubernetes Event on the ContainerRuntime object, so operators can debug per-shoot rollouts with
+// `kubectl describe` and Prometheus alerts.
+func (a *actuator) instrument(cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster, operation, startedReason, succeededReason string, fn func() error) error {
+	shoot := cr.Namespace
+	if cluster != nil && cluster.Shoot != nil {
+		shoot = cluster.Shoot.Name
+	}
+
+	start := time.Now()
+	a.eventRecorder.Event(cr, corev1.EventTypeNormal, startedReason, "containerruntime "+operation+" started")
+
+	err := fn()
+
+	metrics.ObserveOperation(shoot, cr.Spec.Type, operation, start, err)
+
+	if err != nil {
+		a.eventRecorder.Eventf(cr, corev1.EventTypeWarning, startedReason+"Failed", "containerruntime %s failed: %v", operation, err)
+		return err
+	}
+
+	a.eventRecorder.Event(cr, corev1.EventTypeNormal, succeededReason, "containerruntime "+operation+" succeeded")
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package containerruntime wires the generic Gardener ContainerRuntime
+// reconciler to the per-runtime-type actuators hosted by this extension.
+package containerruntime
+
+import (
+	gardenercontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/containerruntime/kata"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+// ControllerName is the name of the ContainerRuntime controller hosted by this extension.
+const ControllerName = "containerruntime"
+
+// AddToManager adds the ContainerRuntime controller to the given manager, with one Actuator registered per
+// entry in enabledRuntimes. Only gvisor and kata-containers are known; other entries are ignored.
+func AddToManager(mgr manager.Manager, enabledRuntimes []string) error {
+	registry := NewActuatorRegistry()
+
+	for _, runtimeType := range enabledRuntimes {
+		switch runtimeType {
+		case gvisor.Type:
+			registry.Register(gvisor.Type, NewActuator(mgr))
+		case kata.Type:
+			registry.Register(kata.Type, kata.NewActuator(mgr))
+		}
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&extensionsv1alpha1.ContainerRuntime{}).
+		WithEventFilter(hasOneOfTypes(registry.Types())).
+		Complete(newOwnerCheckingReconciler(mgr, gardenercontainerruntime.NewReconciler(NewDispatchingActuator(registry))))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardenercontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// dispatchingActuator implements gardenercontainerruntime.Actuator by looking up the Actuator responsible for
+// `cr.Spec.Type` in the registry and delegating to it. Foreign types that have no registered Actuator are
+// rejected with an error carrying the ERR_CONFIGURATION_PROBLEM code instead of being silently reconciled.
+type dispatchingActuator struct {
+	registry *ActuatorRegistry
+}
+
+// NewDispatchingActuator returns an Actuator that dispatches to the Actuator registered for `cr.Spec.Type`.
+func NewDispatchingActuator(registry *ActuatorRegistry) gardenercontainerruntime.Actuator {
+	return &dispatchingActuator{registry: registry}
+}
+
+func (d *dispatchingActuator) Reconcile(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	a, err := d.lookup(cr)
+	if err != nil {
+		return err
+	}
+	return a.Reconcile(ctx, cr, cluster)
+}
+
+func (d *dispatchingActuator) Delete(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	a, err := d.lookup(cr)
+	if err != nil {
+		return err
+	}
+	return a.Delete(ctx, cr, cluster)
+}
+
+func (d *dispatchingActuator) Restore(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	a, err := d.lookup(cr)
+	if err != nil {
+		return err
+	}
+	return a.Restore(ctx, cr, cluster)
+}
+
+func (d *dispatchingActuator) Migrate(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	a, err := d.lookup(cr)
+	if err != nil {
+		return err
+	}
+	return a.Migrate(ctx, cr, cluster)
+}
+
+// lookup resolves the Actuator for `cr.Spec.Type`. An unregistered type is reported with the
+// ERR_CONFIGURATION_PROBLEM error code, which the generic reconciler records in the ContainerRuntime's last
+// error. The generic reconciler still returns the error, so the request is requeued like any other failure.
+func (d *dispatchingActuator) lookup(cr *extensionsv1alpha1.ContainerRuntime) (gardenercontainerruntime.Actuator, error) {
+	a, err := d.registry.Lookup(cr.Spec.Type)
+	if err != nil {
+		return nil, gardencorev1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorConfigurationProblem)
+	}
+	return a, nil
+}
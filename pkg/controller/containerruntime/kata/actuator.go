@@ -0,0 +1,73 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kata is a reference Actuator for the `kata-containers` ContainerRuntime type, demonstrating that this
+// extension can host several runtime backends behind the same ContainerRuntime controller.
+package kata
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardenercontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Type is the ContainerRuntime type this actuator is registered for.
+const Type = "kata-containers"
+
+// BinaryName is the default value for `ContainerRuntime.spec.binaryName`.
+const BinaryName = "kata-runtime"
+
+// RuntimeClassName is the name of the `RuntimeClass` resource installed onto shoot nodes.
+const RuntimeClassName = "kata-containers"
+
+// actuator handles ContainerRuntime resources of type `kata-containers`. All of its operations are no-ops; it
+// does not install or remove the kata-containers runtime or its RuntimeClass.
+type actuator struct {
+	client client.Client
+}
+
+// NewActuator creates a new Actuator for ContainerRuntime resources of type `kata-containers`.
+func NewActuator(mgr manager.Manager) gardenercontainerruntime.Actuator {
+	return &actuator{client: mgr.GetClient()}
+}
+
+// InjectClient injects the controller runtime client into the actuator.
+func (a *actuator) InjectClient(client client.Client) error {
+	a.client = client
+	return nil
+}
+
+// Reconcile is a no-op; it does not create a kata-containers installation DaemonSet or RuntimeClass.
+func (a *actuator) Reconcile(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return nil
+}
+
+// Delete is a no-op; it does not remove a kata-containers installation DaemonSet or RuntimeClass.
+func (a *actuator) Delete(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return nil
+}
+
+// Restore behaves like Reconcile for the kata-containers actuator.
+func (a *actuator) Restore(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return a.Reconcile(ctx, cr, cluster)
+}
+
+// Migrate is a no-op for the kata-containers actuator as it does not provision control plane state.
+func (a *actuator) Migrate(ctx context.Context, cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster) error {
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics exposes Prometheus metrics for the gVisor ContainerRuntime reconciler.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const namespace = "gardener_extension_runtime_gvisor"
+
+var (
+	// ReconcileTotal counts the number of processed operations, labelled by shoot, runtime type and operation.
+	ReconcileTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_total",
+			Help:      "Total number of ContainerRuntime operations processed by the gVisor actuator.",
+		},
+		[]string{"shoot", "runtime_type", "operation"},
+	)
+
+	// ReconcileDurationSeconds observes how long each operation took, labelled by shoot, runtime type and operation.
+	ReconcileDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of ContainerRuntime operations processed by the gVisor actuator.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"shoot", "runtime_type", "operation"},
+	)
+
+	// ReconcileErrorsTotal counts the number of failed operations, labelled by shoot, runtime type and operation.
+	ReconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reconcile_errors_total",
+			Help:      "Total number of ContainerRuntime operations that failed in the gVisor actuator.",
+		},
+		[]string{"shoot", "runtime_type", "operation"},
+	)
+
+	// OwnerCheckFailuresTotal counts how often the seed-ownership check failed before an operation could run.
+	OwnerCheckFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "owner_check_failures_total",
+			Help:      "Total number of times the seed owner check failed for a ContainerRuntime resource.",
+		},
+		[]string{"shoot", "runtime_type"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		ReconcileTotal,
+		ReconcileDurationSeconds,
+		ReconcileErrorsTotal,
+		OwnerCheckFailuresTotal,
+	)
+}
+
+// ObserveOperation records the outcome and duration of a single reconciliation operation.
+func ObserveOperation(shoot, runtimeType, operation string, start time.Time, err error) {
+	labels := prometheus.Labels{"shoot": shoot, "runtime_type": runtimeType, "operation": operation}
+	ReconcileTotal.With(labels).Inc()
+	ReconcileDurationSeconds.With(labels).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ReconcileErrorsTotal.With(labels).Inc()
+	}
+}
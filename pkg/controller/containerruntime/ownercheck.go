@@ -0,0 +1,88 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/common"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/controller/containerruntime/metrics"
+)
+
+// ownerCheckingReconciler wraps the generic ContainerRuntime reconciler to observe the seed-ownership check that
+// happens inside it. The generic reconciler lives in the vendored
+// `github.com/gardener/gardener/extensions/pkg/controller/containerruntime` package and is not something this
+// extension can instrument directly, so the same check is repeated here purely to record metrics and emit an
+// event; the actual reconcile outcome is always determined by the wrapped reconciler.
+type ownerCheckingReconciler struct {
+	client        client.Client
+	inner         reconcile.Reconciler
+	eventRecorder record.EventRecorder
+}
+
+// newOwnerCheckingReconciler wraps inner with owner-check observability.
+func newOwnerCheckingReconciler(mgr manager.Manager, inner reconcile.Reconciler) reconcile.Reconciler {
+	return &ownerCheckingReconciler{
+		client:        mgr.GetClient(),
+		inner:         inner,
+		eventRecorder: mgr.GetEventRecorderFor(ControllerName),
+	}
+}
+
+// Reconcile records an `owner_check_failures_total` metric and an `OwnerCheckFailed` event whenever the seed is
+// not (yet) the owner of the shoot, then always delegates to the wrapped reconciler.
+func (r *ownerCheckingReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	cr := &extensionsv1alpha1.ContainerRuntime{}
+	if err := r.client.Get(ctx, request.NamespacedName, cr); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, r.client, cr.Namespace)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	operationType := gardencorev1beta1helper.ComputeOperationType(cr.ObjectMeta, cr.Status.LastOperation)
+
+	if cluster.Shoot != nil && !extensionscontroller.IsFailed(cluster) && operationType != gardencorev1beta1.LastOperationTypeMigrate {
+		key := "containerruntime:" + kutil.ObjectName(cr)
+		ok, _, cleanup, err := common.GetOwnerCheckResultAndContext(ctx, r.client, cr.Namespace, cluster.Shoot.Name, key)
+		if cleanup != nil {
+			defer cleanup()
+		}
+
+		if err != nil || !ok {
+			metrics.OwnerCheckFailuresTotal.WithLabelValues(cluster.Shoot.Name, cr.Spec.Type).Inc()
+			r.eventRecorder.Event(cr, corev1.EventTypeWarning, "OwnerCheckFailed", "this seed is not (yet) the owner of the shoot")
+		}
+	}
+
+	return r.inner.Reconcile(ctx, request)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// hasOneOfTypes returns a predicate that only lets events for ContainerRuntime resources whose `spec.type` is
+// one of the given runtime types through, so events for runtime types this extension has no Actuator for never
+// reach the controller's work queue.
+func hasOneOfTypes(runtimeTypes []string) predicate.Predicate {
+	types := make(map[string]struct{}, len(runtimeTypes))
+	for _, t := range runtimeTypes {
+		types[t] = struct{}{}
+	}
+
+	matches := func(obj client.Object) bool {
+		cr, ok := obj.(*extensionsv1alpha1.ContainerRuntime)
+		if !ok {
+			return false
+		}
+		_, ok = types[cr.Spec.Type]
+		return ok
+	}
+
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return matches(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return matches(e.ObjectNew) },
+		DeleteFunc:  func(e event.DeleteEvent) bool { return matches(e.Object) },
+		GenericFunc: func(e event.GenericEvent) bool { return matches(e.Object) },
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerruntime
+
+import (
+	"fmt"
+	"sync"
+
+	gardenercontainerruntime "github.com/gardener/gardener/extensions/pkg/controller/containerruntime"
+)
+
+// ActuatorRegistry allows a single running extension to host several ContainerRuntime backends
+// (e.g. gvisor, kata-containers) side by side, selected by `ContainerRuntime.spec.type`.
+type ActuatorRegistry struct {
+	mu        sync.RWMutex
+	actuators map[string]gardenercontainerruntime.Actuator
+}
+
+// NewActuatorRegistry creates an empty ActuatorRegistry.
+func NewActuatorRegistry() *ActuatorRegistry {
+	return &ActuatorRegistry{actuators: map[string]gardenercontainerruntime.Actuator{}}
+}
+
+// Register adds the given Actuator as responsible for ContainerRuntime resources of the given type. Registering
+// the same runtime type twice overwrites the previous registration.
+func (r *ActuatorRegistry) Register(runtimeType string, a gardenercontainerruntime.Actuator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.actuators[runtimeType] = a
+}
+
+// Lookup returns the Actuator registered for the given runtime type, or an error if none is registered.
+func (r *ActuatorRegistry) Lookup(runtimeType string) (gardenercontainerruntime.Actuator, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.actuators[runtimeType]
+	if !ok {
+		return nil, fmt.Errorf("no actuator registered for containerruntime type %q", runtimeType)
+	}
+	return a, nil
+}
+
+// Types returns the set of runtime types that currently have a registered Actuator.
+func (r *ActuatorRegistry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.actuators))
+	for t := range r.actuators {
+		types = append(types, t)
+	}
+	return types
+}
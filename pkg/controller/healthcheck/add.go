@@ -0,0 +1,69 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+const (
+	// ControllerName is the name of the health check controller.
+	ControllerName = "containerruntime-gvisor-healthcheck-controller"
+)
+
+// DefaultAddOptions are the default options to apply when adding the health check controller to the manager.
+var DefaultAddOptions = AddOptions{
+	SyncPeriod:      30 * time.Second,
+	ConcurrentSyncs: 5,
+}
+
+// AddOptions are options to apply when adding the gVisor health check controller to the manager.
+type AddOptions struct {
+	// SyncPeriod is the duration how often the health of existing ContainerRuntime resources is reconciled.
+	SyncPeriod time.Duration
+	// ConcurrentSyncs is the number of concurrent workers used for reconciling the health checks.
+	ConcurrentSyncs int
+}
+
+// AddToManager adds a health check controller for the gVisor ContainerRuntime extension to the given manager.
+func AddToManager(mgr manager.Manager, opts AddOptions) error {
+	return healthcheck.DefaultRegistration(
+		gvisor.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ContainerRuntimeResource),
+		func() client.ObjectList { return &extensionsv1alpha1.ContainerRuntimeList{} },
+		func() client.Object { return &extensionsv1alpha1.ContainerRuntime{} },
+		mgr,
+		healthcheck.DefaultAddArgs{
+			Controller: controller.Options{MaxConcurrentReconciles: opts.ConcurrentSyncs},
+			SyncPeriod: metav1.Duration{Duration: opts.SyncPeriod},
+		},
+		nil,
+		map[healthcheck.HealthCheck]string{
+			general.CheckDaemonSet(gvisor.InstallationDaemonSetName, gvisor.NamespaceSystem): string(gardencorev1beta1.ShootSystemComponentsHealthy),
+			NewRuntimeClassHealthCheck():                                                     string(gardencorev1beta1.ShootControlPlaneHealthy),
+		},
+	)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+// runtimeClassHealthChecker checks that the `gvisor` RuntimeClass exists on the
+// shoot cluster and that at least one node has a working `runsc` installation.
+type runtimeClassHealthChecker struct {
+	shootClient client.Client
+}
+
+// NewRuntimeClassHealthCheck returns a health check that probes the shoot cluster for the `gvisor` RuntimeClass.
+func NewRuntimeClassHealthCheck() healthcheck.HealthCheck {
+	return &runtimeClassHealthChecker{}
+}
+
+// InjectShootClient injects the shoot client into the health check.
+func (h *runtimeClassHealthChecker) InjectShootClient(shootClient client.Client) {
+	h.shootClient = shootClient
+}
+
+// SetLoggerSuffix injects the logger suffix used for this health check.
+func (h *runtimeClassHealthChecker) SetLoggerSuffix(provider, extension string) {}
+
+// DeepCopy clones the health check.
+func (h *runtimeClassHealthChecker) DeepCopy() healthcheck.HealthCheck {
+	copy := *h
+	return &copy
+}
+
+// Check verifies that the `gvisor` RuntimeClass is registered and that at least one node has a working `runsc`
+// installation.
+func (h *runtimeClassHealthChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	runtimeClass := &nodev1.RuntimeClass{}
+	if err := h.shootClient.Get(ctx, client.ObjectKey{Name: gvisor.RuntimeClassName}, runtimeClass); err != nil {
+		if errors.IsNotFound(err) {
+			return &healthcheck.SingleCheckResult{
+				Status: healthcheck.StatusUnhealthy,
+				Detail: fmt.Sprintf("RuntimeClass %q is not registered on the shoot cluster", gvisor.RuntimeClassName),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to get RuntimeClass %q: %w", gvisor.RuntimeClassName, err)
+	}
+
+	// Proving the RuntimeClass is actually usable by scanning every Pod in the shoot for one referencing it
+	// would mean an unbounded, cluster-wide List on every sync, and there is no field index for
+	// `spec.runtimeClassName` on the shoot cache to narrow it. Nodes are comparatively few and already carry
+	// a cheap, authoritative signal that runsc installed successfully: the `RunscReady` condition set by the
+	// installer DaemonSet (see pkg/controller/remedy).
+	nodeList := &corev1.NodeList{}
+	if err := h.shootClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	for i := range nodeList.Items {
+		if nodeReportsGVisorReady(&nodeList.Items[i]) {
+			return &healthcheck.SingleCheckResult{Status: healthcheck.StatusHealthy}, nil
+		}
+	}
+
+	return &healthcheck.SingleCheckResult{
+		Status: healthcheck.StatusUnhealthy,
+		Detail: fmt.Sprintf("no node reports the %q RuntimeClass installation as ready", gvisor.RuntimeClassName),
+	}, nil
+}
+
+// nodeReportsGVisorReady reports whether the node has a condition indicating that the runsc installation
+// completed successfully. The installer DaemonSet sets this condition once it finishes.
+func nodeReportsGVisorReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if string(cond.Type) == "RunscReady" && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
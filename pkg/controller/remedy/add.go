@@ -0,0 +1,105 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remedy runs on the shoot cluster and self-heals nodes whose `runsc` installation is broken, by
+// cordoning the node, recycling the installer Pod and, if the problem persists, forcing the seed-side
+// ContainerRuntime actuator to re-run its install steps.
+package remedy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// ControllerName is the name of the remedy controller.
+const ControllerName = "gvisor-remedy"
+
+// workerPoolLabel is set by machine-controller-manager on every Node, identifying the worker pool it belongs to.
+const workerPoolLabel = "worker.gardener.cloud/pool"
+
+// nodeNameField is the name of the field index registered on Pods so installer/workload Pods can be looked up by
+// the Node they are scheduled to without listing the whole cluster.
+const nodeNameField = "spec.nodeName"
+
+// AddOptions are options to apply when adding the remedy controller to a shoot manager.
+type AddOptions struct {
+	// SeedClient is a client for the seed cluster, used to annotate the ContainerRuntime resource this node
+	// pool belongs to in order to trigger a re-reconcile.
+	SeedClient client.Client
+	// ContainerRuntimeKey identifies the ContainerRuntime resource in the seed that is responsible for this node pool.
+	ContainerRuntimeKey client.ObjectKey
+	// WorkerPoolName restricts the Node watch to nodes of this worker pool, so a remedy instance deployed for one
+	// worker pool never force-reconciles the ContainerRuntime resource of a different pool because of an unrelated
+	// node's failure.
+	WorkerPoolName string
+	// GracePeriod is how long a node is given to recover on its own (via the recycled installer Pod) before the
+	// remedy controller forces a re-reconcile of the ContainerRuntime resource.
+	GracePeriod time.Duration
+	// ConcurrentSyncs is the number of concurrent workers used for reconciling nodes.
+	ConcurrentSyncs int
+}
+
+// DefaultAddOptions are the default options, except for SeedClient, ContainerRuntimeKey and WorkerPoolName which
+// must always be supplied explicitly.
+var DefaultAddOptions = AddOptions{
+	GracePeriod:     10 * time.Minute,
+	ConcurrentSyncs: 5,
+}
+
+// AddToManager adds the remedy controller to the given shoot manager.
+func AddToManager(mgr manager.Manager, opts AddOptions) error {
+	if opts.WorkerPoolName == "" {
+		return fmt.Errorf("WorkerPoolName must not be empty")
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &corev1.Pod{}, nodeNameField, func(o client.Object) []string {
+		pod := o.(*corev1.Pod)
+		if pod.Spec.NodeName == "" {
+			return nil
+		}
+		return []string{pod.Spec.NodeName}
+	}); err != nil {
+		return fmt.Errorf("failed to add %s field indexer to manager: %w", nodeNameField, err)
+	}
+
+	r := &reconciler{
+		client:               mgr.GetClient(),
+		seedClient:           opts.SeedClient,
+		containerRuntimeKey:  opts.ContainerRuntimeKey,
+		gracePeriod:          opts.GracePeriod,
+		firstObservedFailure: map[string]time.Time{},
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Node{}, builder.WithPredicates(inWorkerPool(opts.WorkerPoolName))).
+		WithOptions(controller.Options{MaxConcurrentReconciles: opts.ConcurrentSyncs}).
+		Complete(r)
+}
+
+// inWorkerPool returns a predicate that only lets Node events through for nodes belonging to the given worker pool.
+func inWorkerPool(poolName string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetLabels()[workerPoolLabel] == poolName
+	})
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remedy
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+// reconciler watches shoot Nodes for broken `runsc` installations and self-heals them.
+type reconciler struct {
+	client     client.Client
+	seedClient client.Client
+
+	containerRuntimeKey client.ObjectKey
+	gracePeriod         time.Duration
+
+	mu                   sync.Mutex
+	firstObservedFailure map[string]time.Time
+}
+
+// Reconcile inspects a single Node for gVisor-specific failure modes and, if one is found, cordons the node,
+// recycles the installer Pod and, once the failure has persisted past the grace period, forces the seed-side
+// ContainerRuntime actuator to re-run its install steps.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	logger := log.Log.WithName(ControllerName).WithValues("node", request.Name)
+
+	node := &corev1.Node{}
+	if err := r.client.Get(ctx, request.NamespacedName, node); err != nil {
+		if errors.IsNotFound(err) {
+			r.forgetFailure(request.Name)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	broken, reason, err := r.detectBrokenInstallation(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !broken {
+		if err := r.uncordon(ctx, node); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to uncordon node %s: %w", node.Name, err)
+		}
+		r.forgetFailure(request.Name)
+		return reconcile.Result{}, nil
+	}
+
+	logger.Info("Detected broken gVisor installation", "reason", reason)
+
+	if err := r.cordon(ctx, node); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to cordon node %s: %w", node.Name, err)
+	}
+
+	if err := r.recycleInstallerPod(ctx, node); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to recycle installer pod on node %s: %w", node.Name, err)
+	}
+
+	since := r.observeFailure(node.Name)
+	if time.Since(since) < r.gracePeriod {
+		return reconcile.Result{RequeueAfter: r.gracePeriod - time.Since(since)}, nil
+	}
+
+	logger.Info("Failure persisted past grace period, forcing a re-reconcile of the ContainerRuntime resource")
+	if err := r.triggerContainerRuntimeReconcile(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to annotate containerruntime %s for reconcile: %w", r.containerRuntimeKey, err)
+	}
+	r.forgetFailure(node.Name)
+
+	return reconcile.Result{}, nil
+}
+
+// detectBrokenInstallation reports whether the node exhibits any of the known gVisor failure modes: the
+// installer Pod on this node is crash-looping, or a Pod referencing the `gvisor` RuntimeClass is scheduled on
+// this node while the node has not (yet) reported a successful `runsc` installation.
+func (r *reconciler) detectBrokenInstallation(ctx context.Context, node *corev1.Node) (bool, string, error) {
+	installerPod, err := r.installerPodForNode(ctx, node.Name)
+	if err != nil {
+		return false, "", err
+	}
+
+	if installerPod != nil {
+		for _, cs := range installerPod.Status.ContainerStatuses {
+			if cs.RestartCount > 0 && cs.State.Waiting != nil && cs.State.Waiting.Reason == "CrashLoopBackOff" {
+				return true, fmt.Sprintf("installer pod %s is in CrashLoopBackOff", installerPod.Name), nil
+			}
+		}
+	}
+
+	gvisorPodScheduled, err := r.hasGVisorPodScheduled(ctx, node.Name)
+	if err != nil {
+		return false, "", err
+	}
+
+	if gvisorPodScheduled && !nodeReportsGVisorReady(node) {
+		return true, fmt.Sprintf("node schedules RuntimeClass %q pods but has not reported runsc as ready", gvisor.RuntimeClassName), nil
+	}
+
+	return false, "", nil
+}
+
+func (r *reconciler) installerPodForNode(ctx context.Context, nodeName string) (*corev1.Pod, error) {
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList,
+		client.InNamespace(gvisor.NamespaceSystem),
+		client.MatchingLabels{"app": gvisor.InstallationDaemonSetName},
+		client.MatchingFields{nodeNameField: nodeName},
+	); err != nil {
+		return nil, fmt.Errorf("failed to list installer pods on node %s: %w", nodeName, err)
+	}
+
+	if len(podList.Items) == 0 {
+		return nil, nil
+	}
+	return &podList.Items[0], nil
+}
+
+func (r *reconciler) hasGVisorPodScheduled(ctx context.Context, nodeName string) (bool, error) {
+	podList := &corev1.PodList{}
+	if err := r.client.List(ctx, podList, client.MatchingFields{nodeNameField: nodeName}); err != nil {
+		return false, fmt.Errorf("failed to list pods on node %s: %w", nodeName, err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Spec.RuntimeClassName != nil && *pod.Spec.RuntimeClassName == gvisor.RuntimeClassName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// nodeReportsGVisorReady reports whether the node has a condition indicating that the runsc installation
+// completed successfully. The installer DaemonSet sets this condition once it finishes.
+func nodeReportsGVisorReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if string(cond.Type) == "RunscReady" && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *reconciler) cordon(ctx context.Context, node *corev1.Node) error {
+	if node.Spec.Unschedulable {
+		return nil
+	}
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = true
+	return r.client.Patch(ctx, node, patch)
+}
+
+func (r *reconciler) uncordon(ctx context.Context, node *corev1.Node) error {
+	if !node.Spec.Unschedulable {
+		return nil
+	}
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Spec.Unschedulable = false
+	return r.client.Patch(ctx, node, patch)
+}
+
+func (r *reconciler) recycleInstallerPod(ctx context.Context, node *corev1.Node) error {
+	pod, err := r.installerPodForNode(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+	if pod == nil {
+		return nil
+	}
+	if err := r.client.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *reconciler) triggerContainerRuntimeReconcile(ctx context.Context) error {
+	cr := &metav1.PartialObjectMetadata{}
+	cr.SetGroupVersionKind(extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.ContainerRuntimeResource))
+	cr.Namespace = r.containerRuntimeKey.Namespace
+	cr.Name = r.containerRuntimeKey.Name
+
+	if err := r.seedClient.Get(ctx, r.containerRuntimeKey, cr); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(cr.DeepCopy())
+	if cr.Annotations == nil {
+		cr.Annotations = map[string]string{}
+	}
+	cr.Annotations[v1beta1constants.GardenerOperation] = v1beta1constants.GardenerOperationReconcile
+	return r.seedClient.Patch(ctx, cr, patch)
+}
+
+func (r *reconciler) observeFailure(nodeName string) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if t, ok := r.firstObservedFailure[nodeName]; ok {
+		return t
+	}
+	now := time.Now()
+	r.firstObservedFailure[nodeName] = now
+	return now
+}
+
+func (r *reconciler) forgetFailure(nodeName string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.firstObservedFailure, nodeName)
+}
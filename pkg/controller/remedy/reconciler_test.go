@@ -0,0 +1,154 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remedy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to build scheme: %v", err)
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithIndex(&corev1.Pod{}, nodeNameField, func(o client.Object) []string {
+			pod := o.(*corev1.Pod)
+			if pod.Spec.NodeName == "" {
+				return nil
+			}
+			return []string{pod.Spec.NodeName}
+		}).
+		Build()
+}
+
+func runtimeClassName(name string) *string { return &name }
+
+func TestDetectBrokenInstallation(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+
+	crashingInstaller := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "installer-1", Namespace: gvisor.NamespaceSystem, Labels: map[string]string{"app": gvisor.InstallationDaemonSetName}},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				RestartCount: 3,
+				State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}},
+		},
+	}
+
+	gvisorPodUnready := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "workload-1", Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: "node-1", RuntimeClassName: runtimeClassName(gvisor.RuntimeClassName)},
+	}
+
+	for name, tc := range map[string]struct {
+		objs       []client.Object
+		wantBroken bool
+	}{
+		"healthy node, no pods": {
+			objs:       []client.Object{node},
+			wantBroken: false,
+		},
+		"installer crash-looping": {
+			objs:       []client.Object{node, crashingInstaller},
+			wantBroken: true,
+		},
+		"gvisor pod scheduled but runsc never reported ready": {
+			objs:       []client.Object{node, gvisorPodUnready},
+			wantBroken: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := &reconciler{client: newFakeClient(t, tc.objs...)}
+
+			broken, _, err := r.detectBrokenInstallation(context.Background(), node)
+			if err != nil {
+				t.Fatalf("detectBrokenInstallation returned error: %v", err)
+			}
+			if broken != tc.wantBroken {
+				t.Errorf("detectBrokenInstallation() = %v, want %v", broken, tc.wantBroken)
+			}
+		})
+	}
+}
+
+func TestNodeReportsGVisorReady(t *testing.T) {
+	readyNode := &corev1.Node{Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: "RunscReady", Status: corev1.ConditionTrue}}}}
+	unreadyNode := &corev1.Node{}
+
+	if !nodeReportsGVisorReady(readyNode) {
+		t.Error("expected readyNode to report gVisor as ready")
+	}
+	if nodeReportsGVisorReady(unreadyNode) {
+		t.Error("expected unreadyNode to not report gVisor as ready")
+	}
+}
+
+func TestUncordon(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec:       corev1.NodeSpec{Unschedulable: true},
+	}
+
+	r := &reconciler{client: newFakeClient(t, node)}
+
+	if err := r.uncordon(context.Background(), node); err != nil {
+		t.Fatalf("uncordon() returned error: %v", err)
+	}
+	if node.Spec.Unschedulable {
+		t.Error("expected uncordon() to clear Unschedulable on the passed node")
+	}
+
+	got := &corev1.Node{}
+	if err := r.client.Get(context.Background(), client.ObjectKeyFromObject(node), got); err != nil {
+		t.Fatalf("failed to get node: %v", err)
+	}
+	if got.Spec.Unschedulable {
+		t.Error("expected uncordon() to persist Unschedulable=false")
+	}
+}
+
+func TestObserveAndForgetFailure(t *testing.T) {
+	r := &reconciler{firstObservedFailure: map[string]time.Time{}}
+
+	first := r.observeFailure("node-1")
+	second := r.observeFailure("node-1")
+	if !first.Equal(second) {
+		t.Errorf("observeFailure() should return the same timestamp across repeated calls, got %v and %v", first, second)
+	}
+
+	r.forgetFailure("node-1")
+	third := r.observeFailure("node-1")
+	if third.Equal(first) {
+		t.Error("observeFailure() after forgetFailure() should produce a new timestamp")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gvisor holds the constants shared by the gVisor ContainerRuntime
+// controllers, webhooks and the installation DaemonSet it manages.
+package gvisor
+
+const (
+	// Type is the extension type this extension is registered for, i.e. the
+	// value found in `ContainerRuntime.spec.type`.
+	Type = "gvisor"
+
+	// BinaryName is the default value for `ContainerRuntime.spec.binaryName`.
+	BinaryName = "runsc"
+
+	// RuntimeClassName is the name of the `RuntimeClass` resource that Pods
+	// reference in order to be scheduled onto gVisor sandboxed nodes.
+	RuntimeClassName = "gvisor"
+
+	// InstallationDaemonSetName is the name of the DaemonSet that installs the
+	// `runsc` binary and its supporting configuration onto the shoot nodes.
+	InstallationDaemonSetName = "gvisor-installation"
+
+	// NamespaceSystem is the namespace the installation DaemonSet is deployed
+	// into on the shoot cluster.
+	NamespaceSystem = "kube-system"
+)
@@ -0,0 +1,42 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook bundles the admission webhooks for ContainerRuntime resources of type `gvisor`.
+package webhook
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/webhook/mutator"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/webhook/validator"
+)
+
+// AddToManager registers the validating and mutating webhooks for ContainerRuntime resources with the given manager.
+func AddToManager(mgr manager.Manager) error {
+	validatingWebhook, err := validator.New(mgr)
+	if err != nil {
+		return err
+	}
+
+	mutatingWebhook, err := mutator.New(mgr)
+	if err != nil {
+		return err
+	}
+
+	server := mgr.GetWebhookServer()
+	server.Register("/"+validatingWebhook.Path, validatingWebhook.Handler)
+	server.Register("/"+mutatingWebhook.Path, mutatingWebhook.Handler)
+
+	return nil
+}
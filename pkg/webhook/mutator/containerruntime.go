@@ -0,0 +1,78 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/apis/runtime/v1alpha1"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+// debugAnnotation, when set to "true" on the ContainerRuntime resource, makes the mutator replace
+// `spec.providerConfig` with a ContainerRuntimeConfig that has Debug enabled. The mutator does not inject any
+// `runsc` flags itself.
+const debugAnnotation = "gvisor.extensions.gardener.cloud/debug"
+
+type containerRuntimeMutator struct{}
+
+// NewContainerRuntimeMutator returns a new mutator for `ContainerRuntime` resources of type `gvisor`.
+func NewContainerRuntimeMutator() *containerRuntimeMutator {
+	return &containerRuntimeMutator{}
+}
+
+// Mutate defaults `spec.binaryName` and, if the debug annotation is set, canonicalizes it into
+// `spec.providerConfig` on `ContainerRuntime` resources of type `gvisor`.
+func (m *containerRuntimeMutator) Mutate(ctx context.Context, new, _ client.Object) error {
+	cr, ok := new.(*extensionsv1alpha1.ContainerRuntime)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", new)
+	}
+
+	if cr.Spec.Type != gvisor.Type {
+		return nil
+	}
+
+	if cr.Spec.BinaryName == "" {
+		cr.Spec.BinaryName = gvisor.BinaryName
+	}
+
+	if cr.Annotations[debugAnnotation] == "true" {
+		if err := setDebugProviderConfig(cr); err != nil {
+			return fmt.Errorf("failed to set debug providerConfig: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// setDebugProviderConfig marshals a ContainerRuntimeConfig with Debug enabled into spec.providerConfig, so the
+// actuator receives the debug request as typed, schema-validated configuration rather than an annotation.
+func setDebugProviderConfig(cr *extensionsv1alpha1.ContainerRuntime) error {
+	debug := true
+	raw, err := json.Marshal(&runtimev1alpha1.ContainerRuntimeConfig{Debug: &debug})
+	if err != nil {
+		return err
+	}
+
+	cr.Spec.ProviderConfig = &runtime.RawExtension{Raw: raw}
+	return nil
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package validator implements a validating webhook that rejects malformed
+// gVisor ContainerRuntime resources before the actuator ever sees them.
+package validator
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/extensions/pkg/webhook/validator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// Name is the name of the gVisor ContainerRuntime validating webhook.
+const Name = "containerruntime-gvisor-validator"
+
+// New creates a new validating webhook for `ContainerRuntime` resources of type `gvisor`.
+func New(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return validator.New(mgr, validator.Args{
+		Name: Name,
+		Types: []extensionswebhook.Type{
+			{Obj: &extensionsv1alpha1.ContainerRuntime{}},
+		},
+		Validator: NewContainerRuntimeValidator(mgr.GetClient()),
+	})
+}
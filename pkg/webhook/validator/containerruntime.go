@@ -0,0 +1,146 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"fmt"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/apis/runtime/v1alpha1"
+	"github.com/Kristian-ZH/gardener-extension-runtime-gvisor/pkg/gvisor"
+)
+
+// unsupportedCRIsByKubernetesVersion maps a CRI name to the minimum Kubernetes minor version from which it is
+// no longer supported, e.g. dockershim was removed from Kubernetes 1.24 onwards.
+var unsupportedCRIsByKubernetesVersion = map[string]string{
+	"dockershim": "1.24",
+}
+
+type containerRuntimeValidator struct {
+	client  client.Client
+	decoder runtime.Decoder
+}
+
+// NewContainerRuntimeValidator returns a new validator for `ContainerRuntime` resources of type `gvisor`.
+func NewContainerRuntimeValidator(c client.Client) *containerRuntimeValidator {
+	scheme := runtime.NewScheme()
+	_ = runtimev1alpha1.AddToScheme(scheme)
+	// EnableStrict rejects unrecognized fields instead of silently dropping them, so providerConfig payloads
+	// outside the gVisor schema fail validation rather than being accepted.
+	codecFactory := serializer.NewCodecFactory(scheme, serializer.EnableStrict)
+
+	return &containerRuntimeValidator{
+		client:  c,
+		decoder: codecFactory.UniversalDecoder(),
+	}
+}
+
+// Validate validates the given `ContainerRuntime` resource of type `gvisor`.
+func (v *containerRuntimeValidator) Validate(ctx context.Context, new, _ client.Object) error {
+	cr, ok := new.(*extensionsv1alpha1.ContainerRuntime)
+	if !ok {
+		return fmt.Errorf("unexpected object type %T", new)
+	}
+
+	if cr.Spec.Type != gvisor.Type {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if cr.Spec.BinaryName != "" && cr.Spec.BinaryName != gvisor.BinaryName {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("binaryName"), cr.Spec.BinaryName, []string{gvisor.BinaryName}))
+	}
+
+	cluster, err := extensionscontroller.GetCluster(ctx, v.client, cr.Namespace)
+	if err != nil {
+		return fmt.Errorf("could not get cluster for containerruntime %s/%s: %w", cr.Namespace, cr.Name, err)
+	}
+
+	allErrs = append(allErrs, validateWorkerPool(cr, cluster, specPath)...)
+
+	if cr.Spec.ProviderConfig != nil {
+		if _, _, err := v.decoder.Decode(cr.Spec.ProviderConfig.Raw, nil, &runtimev1alpha1.ContainerRuntimeConfig{}); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("providerConfig"), string(cr.Spec.ProviderConfig.Raw), err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateWorkerPool checks that the ContainerRuntime's worker pool exists in the shoot and, if it specifies a
+// CRI, that the CRI is still supported on the shoot's Kubernetes version. It is split out from Validate so the
+// CRI/worker-pool logic can be covered without a client or a Cluster resource round-trip.
+func validateWorkerPool(cr *extensionsv1alpha1.ContainerRuntime, cluster *extensionscontroller.Cluster, specPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if cluster.Shoot == nil {
+		return allErrs
+	}
+
+	worker := findWorkerPool(cr.Spec.WorkerPool.Name, cluster)
+	if worker == nil {
+		allErrs = append(allErrs, field.NotFound(specPath.Child("workerPool", "name"), cr.Spec.WorkerPool.Name))
+		return allErrs
+	}
+
+	if worker.CRI == nil {
+		return allErrs
+	}
+
+	minVersion, forbidden := unsupportedCRIsByKubernetesVersion[string(worker.CRI.Name)]
+	if !forbidden {
+		return allErrs
+	}
+
+	kubernetesVersion := cluster.Shoot.Spec.Kubernetes.Version
+	if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+		kubernetesVersion = *worker.Kubernetes.Version
+	}
+
+	unsupported, err := versionutils.CompareVersions(kubernetesVersion, ">=", minVersion)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(specPath.Child("workerPool"), fmt.Errorf("could not compare kubernetes version %q to %q: %w", kubernetesVersion, minVersion, err)))
+		return allErrs
+	}
+
+	if unsupported {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("workerPool"), fmt.Sprintf("CRI %q is not supported on Kubernetes %s+ (shoot runs %s)", worker.CRI.Name, minVersion, kubernetesVersion)))
+	}
+
+	return allErrs
+}
+
+func findWorkerPool(name string, cluster *extensionscontroller.Cluster) *gardencorev1beta1.Worker {
+	if cluster.Shoot == nil {
+		return nil
+	}
+	for i, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name == name {
+			return &cluster.Shoot.Spec.Provider.Workers[i]
+		}
+	}
+	return nil
+}
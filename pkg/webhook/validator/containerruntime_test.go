@@ -0,0 +1,113 @@
+// Copyright (c) 2021 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"testing"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func shootWithWorkers(kubernetesVersion string, workers ...gardencorev1beta1.Worker) *extensionscontroller.Cluster {
+	return &extensionscontroller.Cluster{
+		Shoot: &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: kubernetesVersion,
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: workers,
+				},
+			},
+		},
+	}
+}
+
+func TestValidateWorkerPool(t *testing.T) {
+	specPath := field.NewPath("spec")
+
+	for name, tc := range map[string]struct {
+		cr       *extensionsv1alpha1.ContainerRuntime
+		cluster  *extensionscontroller.Cluster
+		wantErrs int
+	}{
+		"no shoot yet (e.g. restore/migrate flow)": {
+			cr:       crWithWorkerPool("pool-a"),
+			cluster:  &extensionscontroller.Cluster{},
+			wantErrs: 0,
+		},
+		"worker pool exists, no CRI set": {
+			cr:       crWithWorkerPool("pool-a"),
+			cluster:  shootWithWorkers("1.25.0", gardencorev1beta1.Worker{Name: "pool-a"}),
+			wantErrs: 0,
+		},
+		"worker pool does not exist": {
+			cr:       crWithWorkerPool("pool-missing"),
+			cluster:  shootWithWorkers("1.25.0", gardencorev1beta1.Worker{Name: "pool-a"}),
+			wantErrs: 1,
+		},
+		"dockershim forbidden on Kubernetes 1.24+": {
+			cr: crWithWorkerPool("pool-a"),
+			cluster: shootWithWorkers("1.24.0", gardencorev1beta1.Worker{
+				Name: "pool-a",
+				CRI:  &gardencorev1beta1.CRI{Name: "dockershim"},
+			}),
+			wantErrs: 1,
+		},
+		"dockershim still allowed below Kubernetes 1.24": {
+			cr: crWithWorkerPool("pool-a"),
+			cluster: shootWithWorkers("1.23.5", gardencorev1beta1.Worker{
+				Name: "pool-a",
+				CRI:  &gardencorev1beta1.CRI{Name: "dockershim"},
+			}),
+			wantErrs: 0,
+		},
+		"worker pool kubernetes version override is used over the shoot's": {
+			cr: crWithWorkerPool("pool-a"),
+			cluster: shootWithWorkers("1.24.0", gardencorev1beta1.Worker{
+				Name:       "pool-a",
+				CRI:        &gardencorev1beta1.CRI{Name: "dockershim"},
+				Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: strPtr("1.23.5")},
+			}),
+			wantErrs: 0,
+		},
+		"worker pool uses a supported CRI": {
+			cr: crWithWorkerPool("pool-a"),
+			cluster: shootWithWorkers("1.25.0", gardencorev1beta1.Worker{
+				Name: "pool-a",
+				CRI:  &gardencorev1beta1.CRI{Name: "containerd"},
+			}),
+			wantErrs: 0,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			errs := validateWorkerPool(tc.cr, tc.cluster, specPath)
+			if len(errs) != tc.wantErrs {
+				t.Errorf("validateWorkerPool() = %v, want %d error(s)", errs, tc.wantErrs)
+			}
+		})
+	}
+}
+
+func crWithWorkerPool(name string) *extensionsv1alpha1.ContainerRuntime {
+	cr := &extensionsv1alpha1.ContainerRuntime{}
+	cr.Spec.WorkerPool.Name = name
+	return cr
+}
+
+func strPtr(s string) *string { return &s }